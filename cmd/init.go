@@ -4,21 +4,30 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/AkhilSharma90/AI-Code-Bundler/internal/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-// Define a default template configuration
-var defaultConfig = []byte(`# Configuration for the tool
+// allowedConfigFormats are the config formats init can emit, and the
+// file extension each one is written with.
+var allowedConfigFormats = map[string]string{
+	"yaml": "yaml",
+	"yml":  "yaml",
+	"json": "json",
+	"toml": "toml",
+}
 
-# specify the prefixes of files and directories to ignore (by default common configuration files are ignored)
-ignore-pre: # ex. [tests, readme.md, scripts]
-# specify the extensions of files to ignore 
-ignore-ext: # ex. [.go, .py, .js]
-# specify the extensions of files to include 
-include-ext: # ex. [.go, .py, .js]
-`)
+var (
+	initFilename    string
+	initGlobal      bool
+	initFormat      string
+	initForce       bool
+	initInteractive bool
+)
 
 var initCmd = &cobra.Command{
 	Use:   "init",
@@ -30,29 +39,119 @@ The configuration file includes:
 - File and directory ignore patterns when generating the project overview
 - File extensions to include when generating the project overview
 
-You can modify this file as needed to suit your project's structure.
+Use --filename to choose a different name or location, --format to pick
+the serialization (yaml, json or toml), and --global to write a config
+under the user's home directory instead, so it applies to every project.
+--filename's extension is advisory only: the generated file's extension
+always follows --format, so an unrecognized extension on --filename is
+silently replaced rather than rejected.
+
+By default init refuses to touch an existing config. Pass --force to
+overwrite it anyway; the previous file is backed up alongside it with a
+".bak" suffix first.
+
+Pass --interactive to scan the working directory instead of writing
+empty placeholder lists: init tallies file extensions and top-level
+directories and asks which to include or ignore before writing the file.
+
+Exit codes: 0 success, 1 I/O error, 2 config already exists.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		configFileName := ".codefuse-config.yaml"
+		format, ok := allowedConfigFormats[strings.ToLower(initFormat)]
+		if !ok {
+			fmt.Fprintln(os.Stderr, "Unsupported config format:", initFormat, "(expected one of yaml, json, toml)")
+			os.Exit(1)
+		}
+
+		configFileName := initFilename
+		if initGlobal {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Unable to determine home directory: ", err)
+				os.Exit(1)
+			}
+
+			configDir := filepath.Join(home, ".codefuse")
+			if err := os.MkdirAll(configDir, 0755); err != nil {
+				fmt.Fprintln(os.Stderr, "Unable to create global config directory: ", err)
+				os.Exit(1)
+			}
 
-		// Check if the config file already exists
-		if viper.ConfigFileUsed() != "" {
-			fmt.Println("Config file already exists at ", viper.ConfigFileUsed())
+			configFileName = filepath.Join(configDir, "config")
+		}
+
+		// The chosen format always wins over whatever extension was
+		// supplied via --filename — unlike the original chunk0-1
+		// behavior, an unrecognized --filename extension is replaced
+		// rather than rejected.
+		configFileName = config.ResolveFilename(configFileName, format)
+
+		// Check if the config file already exists. We stat the target
+		// directly rather than relying on viper.ConfigFileUsed(), since
+		// that depends on the root command having already loaded a
+		// config at this point.
+		if _, err := os.Stat(configFileName); err == nil {
+			if !initForce {
+				fmt.Fprintln(os.Stderr, "Config file already exists at", configFileName, "(use --force to overwrite)")
+				os.Exit(2)
+			}
+
+			backupFileName := configFileName + ".bak"
+			if err := os.Rename(configFileName, backupFileName); err != nil {
+				fmt.Fprintln(os.Stderr, "Unable to back up existing config file: ", err)
+				os.Exit(1)
+			}
+			fmt.Fprintln(os.Stderr, "Backed up existing config file to", backupFileName)
+		} else if !os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Unable to check for existing config file: ", err)
 			os.Exit(1)
 		}
 
-		// Write the default config using Viper
-		err := os.WriteFile(configFileName, defaultConfig, 0644)
-		if err != nil {
-			fmt.Println("Unable to write config file: ", err)
+		// Write through an isolated viper instance rather than the
+		// shared singleton: by the time Run executes, root.go's
+		// cobra.OnInitialize(initConfig) has already called
+		// viper.ReadInConfig() against that singleton, so generating
+		// the "fresh default" config from it would silently carry over
+		// whatever an existing local or --global config had set (API
+		// keys included) instead of writing defaults.
+		newConfig := viper.New()
+		config.SetDefaults(newConfig)
+
+		if initInteractive {
+			wd, err := os.Getwd()
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Unable to determine working directory: ", err)
+				os.Exit(1)
+			}
+
+			answers, err := runInteractiveWizard(wd)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Interactive setup failed: ", err)
+				os.Exit(1)
+			}
+
+			newConfig.Set("ignore-pre", answers.IgnorePre)
+			newConfig.Set("ignore-ext", answers.IgnoreExt)
+			newConfig.Set("include-ext", answers.IncludeExt)
+		}
+		newConfig.SetConfigType(format)
+
+		if err := newConfig.WriteConfigAs(configFileName); err != nil {
+			fmt.Fprintln(os.Stderr, "Unable to write config file: ", err)
 			os.Exit(1)
 		}
 
 		// Inform the user
-		fmt.Println("Config file created at:", configFileName)
+		fmt.Fprintln(os.Stderr, "Config file created at:", configFileName)
 	},
 }
 
 func init() {
+	initCmd.Flags().StringVarP(&initFilename, "filename", "f", ".codefuse-config.yaml", "name (or path) of the config file to generate")
+	initCmd.Flags().BoolVarP(&initGlobal, "global", "g", false, "write the config under $HOME/.codefuse instead of the working directory")
+	initCmd.Flags().StringVar(&initFormat, "format", "yaml", "config file format to generate (yaml, json or toml)")
+	// -f is already taken by --filename, so --force uses -F instead.
+	initCmd.Flags().BoolVarP(&initForce, "force", "F", false, "overwrite an existing config file, after backing it up with a .bak suffix")
+	initCmd.Flags().BoolVarP(&initInteractive, "interactive", "i", false, "scan the working directory and prompt for include/ignore choices")
 	rootCmd.AddCommand(initCmd)
 }
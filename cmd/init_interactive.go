@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"sort"
+
+	"github.com/AkhilSharma90/AI-Code-Bundler/internal/config"
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// commonlyIgnoredDirs are pre-selected in the "which directories should
+// be ignored" prompt, since they're almost never part of the code a
+// user wants bundled.
+var commonlyIgnoredDirs = []string{"vendor", "node_modules", "dist"}
+
+// runInteractiveWizard scans root and walks the user through confirming
+// which extensions to include, which to ignore, and which top-level
+// directories to add to ignore-pre, returning a Config built from their
+// answers rather than empty placeholder lists.
+func runInteractiveWizard(root string) (config.Config, error) {
+	scan, err := config.Scan(root)
+	if err != nil {
+		return config.Config{}, err
+	}
+
+	extensions := make([]string, 0, len(scan.ExtensionCounts))
+	for ext := range scan.ExtensionCounts {
+		extensions = append(extensions, ext)
+	}
+	sort.Slice(extensions, func(i, j int) bool {
+		return scan.ExtensionCounts[extensions[i]] > scan.ExtensionCounts[extensions[j]]
+	})
+
+	var includeExt []string
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message: "Which file extensions should be included when bundling?",
+		Options: extensions,
+		Default: extensions,
+	}, &includeExt); err != nil {
+		return config.Config{}, err
+	}
+
+	var ignoreExt []string
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message: "Which file extensions should be ignored?",
+		Options: extensions,
+	}, &ignoreExt); err != nil {
+		return config.Config{}, err
+	}
+
+	var ignoreDirs []string
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message: "Which top-level directories should be added to ignore-pre?",
+		Options: scan.TopLevelDirs,
+		Default: intersect(scan.TopLevelDirs, commonlyIgnoredDirs),
+	}, &ignoreDirs); err != nil {
+		return config.Config{}, err
+	}
+
+	return config.Config{
+		IgnorePre:  ignoreDirs,
+		IgnoreExt:  ignoreExt,
+		IncludeExt: includeExt,
+	}, nil
+}
+
+// intersect returns the elements of a that also appear in b, preserving a's order.
+func intersect(a, b []string) []string {
+	set := make(map[string]bool, len(b))
+	for _, v := range b {
+		set[v] = true
+	}
+
+	var out []string
+	for _, v := range a {
+		if set[v] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
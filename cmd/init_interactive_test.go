@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntersect(t *testing.T) {
+	cases := []struct {
+		a, b, want []string
+	}{
+		{[]string{"vendor", "src", "dist"}, []string{"vendor", "dist", "node_modules"}, []string{"vendor", "dist"}},
+		{[]string{"src"}, []string{"vendor"}, nil},
+		{nil, []string{"vendor"}, nil},
+	}
+
+	for _, c := range cases {
+		got := intersect(c.a, c.b)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("intersect(%v, %v) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
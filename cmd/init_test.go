@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+// TestInitFlagShorthandsDoNotCollide guards against the --force/--filename
+// shorthand clash: both were specced as -f, which cobra would refuse to
+// register. --force was moved to -F instead.
+func TestInitFlagShorthandsDoNotCollide(t *testing.T) {
+	seen := map[string]string{}
+	initCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if f.Shorthand == "" {
+			return
+		}
+		if name, ok := seen[f.Shorthand]; ok {
+			t.Errorf("shorthand -%s is used by both --%s and --%s", f.Shorthand, name, f.Name)
+		}
+		seen[f.Shorthand] = f.Name
+	})
+}
@@ -0,0 +1,65 @@
+// Description: This file wires up the root Cobra command and the global Viper configuration lookup.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "codefuse",
+	Short: "A CLI for bundling and reviewing code with the help of AI",
+	Long: `codefuse bundles a project's source files into a single context and can
+send that context to an AI service for review.
+
+Run "codefuse init" to generate a configuration file before using the
+other commands.
+`,
+}
+
+// Execute runs the root command, exiting the process on error.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+}
+
+// configSearchExts are the extensions this lookup knows how to find: the
+// ones init generates (yaml, json, toml) plus yml.
+var configSearchExts = []string{"yaml", "yml", "json", "toml"}
+
+// initConfig locates a config written by "init" (locally or with
+// --global) and loads it, so it's picked up automatically on later runs.
+// A local ".codefuse-config.*" takes priority over the global one under
+// $HOME/.codefuse.
+func initConfig() {
+	viper.AutomaticEnv()
+
+	candidates := []string{}
+	for _, ext := range configSearchExts {
+		candidates = append(candidates, fmt.Sprintf(".codefuse-config.%s", ext))
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		for _, ext := range configSearchExts {
+			candidates = append(candidates, filepath.Join(home, ".codefuse", fmt.Sprintf("config.%s", ext)))
+		}
+	}
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err != nil {
+			continue
+		}
+		viper.SetConfigFile(candidate)
+		_ = viper.ReadInConfig()
+		return
+	}
+}
@@ -0,0 +1,53 @@
+// Package config defines the tool's configuration schema and its
+// defaults, so that every command that needs config reads the same
+// shape instead of parsing raw YAML on its own.
+package config
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Config mirrors the keys written by "init" and read by commands such
+// as "review".
+type Config struct {
+	IgnorePre  []string `mapstructure:"ignore-pre"`
+	IgnoreExt  []string `mapstructure:"ignore-ext"`
+	IncludeExt []string `mapstructure:"include-ext"`
+	APIKey     string   `mapstructure:"api-key"`
+}
+
+// SetDefaults registers the tool's default config values on v so that
+// WriteConfigAs has something to serialize and commands have a sane
+// fallback even without a config file on disk. Callers that need a
+// config isolated from whatever the shared viper singleton already
+// loaded (e.g. "init" generating a fresh file) should pass a
+// viper.New() instance rather than viper.GetViper().
+func SetDefaults(v *viper.Viper) {
+	v.SetDefault("ignore-pre", []string{})
+	v.SetDefault("ignore-ext", []string{})
+	v.SetDefault("include-ext", []string{})
+	v.SetDefault("api-key", "")
+}
+
+// Load reads the currently configured viper values into a Config.
+func Load() (Config, error) {
+	var cfg Config
+	err := viper.Unmarshal(&cfg)
+	return cfg, err
+}
+
+// ResolveFilename derives the path "init" should write to from the
+// requested filename and format: the chosen format's extension always
+// wins over whatever extension the filename already had.
+func ResolveFilename(filename, extension string) string {
+	base := filepath.Base(filename)
+	ext := filepath.Ext(base)
+	if ext == base {
+		// A dotfile such as ".codefuse-config" has no extension to replace.
+		ext = ""
+	}
+	return strings.TrimSuffix(filename, ext) + "." + extension
+}
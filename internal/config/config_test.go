@@ -0,0 +1,38 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestResolveFilename(t *testing.T) {
+	cases := []struct {
+		filename  string
+		extension string
+		want      string
+	}{
+		{".codefuse-config.yaml", "yaml", ".codefuse-config.yaml"},
+		{".codefuse-config.yaml", "json", ".codefuse-config.json"},
+		{"custom", "toml", "custom.toml"},
+		{"/home/user/.codefuse/config", "yaml", "/home/user/.codefuse/config.yaml"},
+	}
+
+	for _, c := range cases {
+		if got := ResolveFilename(c.filename, c.extension); got != c.want {
+			t.Errorf("ResolveFilename(%q, %q) = %q, want %q", c.filename, c.extension, got, c.want)
+		}
+	}
+}
+
+func TestSetDefaultsDoesNotLeakAcrossInstances(t *testing.T) {
+	loaded := viper.New()
+	loaded.Set("api-key", "SUPER-SECRET-KEY")
+
+	fresh := viper.New()
+	SetDefaults(fresh)
+
+	if fresh.GetString("api-key") != "" {
+		t.Errorf("fresh viper instance should not see values set on an unrelated instance, got api-key=%q", fresh.GetString("api-key"))
+	}
+}
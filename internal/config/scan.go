@@ -0,0 +1,108 @@
+package config
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ScanResult tallies what Scan found in a directory tree.
+type ScanResult struct {
+	ExtensionCounts map[string]int
+	TopLevelDirs    []string
+}
+
+// defaultIgnoredDirs are never walked into, at any depth, even if
+// .gitignore doesn't mention them, since their contents are never useful
+// to bundle.
+var defaultIgnoredDirs = map[string]bool{
+	".git":         true,
+	"vendor":       true,
+	"node_modules": true,
+	"dist":         true,
+}
+
+// Scan walks root, tallying file extensions and top-level directory
+// names so "init --interactive" can suggest sensible include/ignore
+// lists instead of empty placeholders. Paths matched by a .gitignore at
+// root are skipped for both the extension tally and the directory
+// listing. vendor/node_modules/dist are deliberately left in
+// TopLevelDirs (only their contents are skipped) so the wizard's
+// "commonly ignored" pre-selection has something real to select from.
+func Scan(root string) (ScanResult, error) {
+	ignore := loadGitignore(root)
+	result := ScanResult{ExtensionCounts: map[string]int{}}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return result, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == ".git" || ignore.matches(entry.Name()) {
+			continue
+		}
+		result.TopLevelDirs = append(result.TopLevelDirs, entry.Name())
+	}
+
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil || rel == "." {
+			return nil
+		}
+
+		if d.IsDir() {
+			if defaultIgnoredDirs[d.Name()] || ignore.matches(rel) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if ignore.matches(rel) {
+			return nil
+		}
+		if ext := filepath.Ext(d.Name()); ext != "" {
+			result.ExtensionCounts[ext]++
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// gitignoreMatcher is a minimal .gitignore matcher covering plain
+// path and directory-prefix patterns, which is enough to keep a scan
+// out of whatever the project has already chosen to ignore.
+type gitignoreMatcher struct {
+	patterns []string
+}
+
+func loadGitignore(root string) gitignoreMatcher {
+	data, err := os.ReadFile(filepath.Join(root, ".gitignore"))
+	if err != nil {
+		return gitignoreMatcher{}
+	}
+
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, strings.Trim(line, "/"))
+	}
+	return gitignoreMatcher{patterns: patterns}
+}
+
+func (m gitignoreMatcher) matches(path string) bool {
+	path = filepath.ToSlash(path)
+	for _, pattern := range m.patterns {
+		if path == pattern || strings.HasPrefix(path, pattern+"/") {
+			return true
+		}
+	}
+	return false
+}
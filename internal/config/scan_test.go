@@ -0,0 +1,85 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGitignoreMatcherMatches(t *testing.T) {
+	m := gitignoreMatcher{patterns: []string{"vendor", "dist", "build/tmp"}}
+
+	cases := []struct {
+		path string
+		want bool
+	}{
+		{"vendor", true},
+		{"vendor/pkg/file.go", true},
+		{"dist", true},
+		{"build/tmp", true},
+		{"build/tmp/out.txt", true},
+		{"main.go", false},
+		{"build", false},
+	}
+
+	for _, c := range cases {
+		if got := m.matches(c.path); got != c.want {
+			t.Errorf("matches(%q) = %v, want %v", c.path, got, c.want)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "main.go"), "")
+	writeFile(t, filepath.Join(root, "README.md"), "")
+	writeFile(t, filepath.Join(root, "src", "app.go"), "")
+	writeFile(t, filepath.Join(root, "vendor", "dep.go"), "")
+	writeFile(t, filepath.Join(root, "node_modules", "pkg.js"), "")
+	writeFile(t, filepath.Join(root, "ignored", "skip.go"), "")
+	writeFile(t, filepath.Join(root, ".gitignore"), "ignored\n")
+
+	result, err := Scan(root)
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if result.ExtensionCounts[".go"] != 2 {
+		t.Errorf("ExtensionCounts[.go] = %d, want 2 (main.go + src/app.go, vendor/ and ignored/ excluded)", result.ExtensionCounts[".go"])
+	}
+	if result.ExtensionCounts[".md"] != 1 {
+		t.Errorf("ExtensionCounts[.md] = %d, want 1", result.ExtensionCounts[".md"])
+	}
+	if count, ok := result.ExtensionCounts[".js"]; ok {
+		t.Errorf("ExtensionCounts[.js] = %d, want node_modules/ excluded entirely", count)
+	}
+
+	for _, dir := range []string{"vendor", "node_modules", "src"} {
+		if !containsString(result.TopLevelDirs, dir) {
+			t.Errorf("TopLevelDirs = %v, want it to contain %q (only contents of vendor/node_modules are skipped, not the listing)", result.TopLevelDirs, dir)
+		}
+	}
+	if containsString(result.TopLevelDirs, "ignored") {
+		t.Errorf("TopLevelDirs = %v, want %q excluded by .gitignore", result.TopLevelDirs, "ignored")
+	}
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("MkdirAll(%q) error = %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("WriteFile(%q) error = %v", path, err)
+	}
+}